@@ -0,0 +1,12 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+namespace Aspire.Hosting.ApplicationModel;
+
+/// <summary>
+/// Records the names of endpoints that should be exposed externally at publish time.
+/// </summary>
+internal sealed class ExternalEndpointsAnnotation : IResourceAnnotation
+{
+    public HashSet<string> EndpointNames { get; } = new();
+}
@@ -86,10 +86,14 @@ public static IResourceBuilder<ContainerResource> AddContainer(this IDistributed
     /// <summary>
     /// Adds the arguments to be passed to a container resource when the container is started.
     /// </summary>
+    /// <remarks>
+    /// Each call appends its arguments after those added by previous calls. Arguments are passed to the container as
+    /// discrete values in the order they were added; no shell splitting or quoting is applied.
+    /// </remarks>
     /// <typeparam name="T">The resource type.</typeparam>
     /// <param name="builder">The resource builder.</param>
     /// <param name="args">The arguments to be passed to the container when it is started.</param>
-    /// <returns>The <see cref="IResourceBuilder{T}"/>.</returns>    
+    /// <returns>The <see cref="IResourceBuilder{T}"/>.</returns>
     public static IResourceBuilder<T> WithArgs<T>(this IResourceBuilder<T> builder, params string[] args) where T : ContainerResource
     {
         var annotation = new ExecutableArgsCallbackAnnotation(updatedArgs =>
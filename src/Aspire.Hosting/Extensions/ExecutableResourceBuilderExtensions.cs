@@ -18,7 +18,7 @@ public static class ExecutableResourceBuilderExtensions
     /// <param name="builder">The <see cref="IDistributedApplicationBuilder"/>.</param>
     /// <param name="name">The name of the resource.</param>
     /// <param name="command">The executable path. This can be a fully qualified path or a executable to run from the shell/command line.</param>
-    /// <param name="workingDirectory">The working directory of the executable.</param>
+    /// <param name="workingDirectory">The working directory of the executable. Relative paths are resolved against <see cref="IDistributedApplicationBuilder.AppHostDirectory"/>, and an empty string uses the AppHost directory itself.</param>
     /// <param name="args">The arguments to the executable.</param>
     /// <returns>The <see cref="IResourceBuilder{ExecutableResource}"/>.</returns>
     public static IResourceBuilder<ExecutableResource> AddExecutable(this IDistributedApplicationBuilder builder, string name, string command, string workingDirectory, params string[]? args)
@@ -43,6 +43,10 @@ public static IResourceBuilder<ExecutableResource> AddExecutable(this IDistribut
     /// <summary>
     /// Adds the arguments to be passed to an executable resource when the executable is started.
     /// </summary>
+    /// <remarks>
+    /// The arguments are appended after those passed to AddExecutable and any earlier calls to this method.
+    /// Each argument reaches the process as a single value, so values containing spaces do not need quoting.
+    /// </remarks>
     /// <typeparam name="T">The resource type.</typeparam>
     /// <param name="builder">The resource builder.</param>
     /// <param name="args">The arguments to be passed to the executable when it is started.</param>
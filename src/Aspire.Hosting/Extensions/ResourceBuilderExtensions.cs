@@ -74,6 +74,74 @@ public static class ResourceBuilderExtensions
         }));
     }
 
+    /// <summary>
+    /// Removes an environment variable from the resource.
+    /// </summary>
+    /// <typeparam name="T">The resource type.</typeparam>
+    /// <param name="builder">The resource builder.</param>
+    /// <param name="name">The name of the environment variable to remove.</param>
+    /// <returns>A resource configured without the specified environment variable.</returns>
+    /// <remarks>
+    /// Environment callbacks run in the order they were added, so this removes the variable if it was set by the launch profile or by
+    /// configuration applied before this call (such as defaults added by <c>AddProject</c>). A variable set again by a later call is kept.
+    /// When running locally, executables and projects inherit any variable the AppHost does not set, so for those resources the variable
+    /// is set to an empty value instead of being dropped, which also stops it from being inherited from the AppHost process.
+    /// </remarks>
+    public static IResourceBuilder<T> WithoutEnvironment<T>(this IResourceBuilder<T> builder, string name) where T : IResourceWithEnvironment
+    {
+        ArgumentException.ThrowIfNullOrEmpty(name);
+
+        return builder.WithEnvironment(context =>
+        {
+            // DCP executables inherit the AppHost environment for any variable missing from their spec,
+            // so removing the variable would re-enable inheritance rather than unset it.
+            if (context.PublisherName == "dcp" && builder.Resource is not ContainerResource)
+            {
+                context.EnvironmentVariables[name] = string.Empty;
+            }
+            else
+            {
+                context.EnvironmentVariables.Remove(name);
+            }
+        });
+    }
+
+    /// <summary>
+    /// Adds the environment variables defined in an environment file (e.g. <c>.env</c>) to the resource.
+    /// </summary>
+    /// <typeparam name="T">The resource type.</typeparam>
+    /// <param name="builder">The resource builder.</param>
+    /// <param name="path">The path to the environment file. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A resource configured with the environment variables from the file.</returns>
+    /// <remarks>
+    /// The file contains one <c>NAME=VALUE</c> pair per line, optionally prefixed with <c>export</c>. Blank lines and lines starting
+    /// with <c>#</c> are ignored. Unquoted values end at a <c>#</c> preceded by whitespace. Values may be wrapped in single quotes
+    /// (taken literally) or double quotes (supporting <c>\n</c>, <c>\r</c>, <c>\t</c>, <c>\"</c> and <c>\\</c> escapes).
+    /// Lines without a value (<c>NAME</c>) and <c>${VAR}</c> interpolation are not supported. The file is read when this method is called.
+    /// </remarks>
+    /// <exception cref="DistributedApplicationException">Thrown if the file does not exist or contains a line that cannot be parsed.</exception>
+    public static IResourceBuilder<T> WithEnvironmentFile<T>(this IResourceBuilder<T> builder, string path) where T : IResourceWithEnvironment
+    {
+        ArgumentException.ThrowIfNullOrEmpty(path);
+
+        path = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, path));
+
+        if (!File.Exists(path))
+        {
+            throw new DistributedApplicationException($"Environment file '{path}' does not exist.");
+        }
+
+        var variables = EnvironmentFileParser.Parse(path);
+
+        return builder.WithEnvironment(context =>
+        {
+            foreach (var (name, value) in variables)
+            {
+                context.EnvironmentVariables[name] = value;
+            }
+        });
+    }
+
     /// <summary>
     /// Registers a callback which is invoked when manifest is generated for the app model.
     /// </summary>
@@ -352,6 +420,39 @@ private static void ApplyEndpoints<T>(this IResourceBuilder<T> builder, IResourc
         return builder.WithAnnotation(new Http2ServiceAnnotation());
     }
 
+    /// <summary>
+    /// Marks the named endpoints of a resource as external so that they are exposed outside of the application when it is published.
+    /// Endpoints that are not named remain internal.
+    /// </summary>
+    /// <typeparam name="T">The resource type.</typeparam>
+    /// <param name="builder">The resource builder.</param>
+    /// <param name="endpointNames">The names of the endpoints to expose externally.</param>
+    /// <returns>The <see cref="IResourceBuilder{T}"/>.</returns>
+    /// <remarks>
+    /// External endpoints only affect the published manifest, so the names are only validated when publishing the manifest, after
+    /// the default http and https endpoints have been added to projects. A <see cref="DistributedApplicationException"/> is thrown
+    /// at that point if the resource has no endpoint with one of the names. Names are not checked when running the application locally.
+    /// </remarks>
+    public static IResourceBuilder<T> WithExternalEndpoints<T>(this IResourceBuilder<T> builder, params string[] endpointNames) where T : IResourceWithBindings
+    {
+        ArgumentNullException.ThrowIfNull(endpointNames);
+        ArgumentOutOfRangeException.ThrowIfZero(endpointNames.Length);
+
+        if (!builder.Resource.TryGetLastAnnotation<ExternalEndpointsAnnotation>(out var externalEndpointsAnnotation))
+        {
+            externalEndpointsAnnotation = new ExternalEndpointsAnnotation();
+            builder.WithAnnotation(externalEndpointsAnnotation);
+        }
+
+        foreach (var endpointName in endpointNames)
+        {
+            ArgumentException.ThrowIfNullOrEmpty(endpointName);
+            externalEndpointsAnnotation.EndpointNames.Add(endpointName);
+        }
+
+        return builder;
+    }
+
     /// <summary>
     /// Excludes a resource from being published to the manifest.
     /// </summary>
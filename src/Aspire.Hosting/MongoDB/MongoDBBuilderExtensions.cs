@@ -4,6 +4,7 @@
 using System.Net.Sockets;
 using Aspire.Hosting.ApplicationModel;
 using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -71,6 +72,31 @@ public static IResourceBuilder<MongoDBDatabaseResource> AddDatabase(this IResour
             .WithManifestPublishingCallback(context => context.WriteMongoDBDatabaseToManifest(mongoDBDatabase));
     }
 
+    /// <summary>
+    /// Adds a named volume for the data folder to a MongoDB container resource. The volume is mounted at <c>/data/db</c> in the container.
+    /// </summary>
+    /// <param name="builder">The MongoDB container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{MongoDBContainerResource}"/>.</returns>
+    public static IResourceBuilder<MongoDBContainerResource> WithDataVolume(this IResourceBuilder<MongoDBContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/data/db", VolumeMountType.Named);
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to a MongoDB container resource. The source is mounted at <c>/data/db</c> in the container.
+    /// </summary>
+    /// <param name="builder">The MongoDB container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{MongoDBContainerResource}"/>.</returns>
+    public static IResourceBuilder<MongoDBContainerResource> WithDataBindMount(this IResourceBuilder<MongoDBContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/data/db", VolumeMountType.Bind);
+    }
+
     private static void WriteMongoDBContainerToManifest(this ManifestPublishingContext context, MongoDBContainerResource resource)
     {
         context.WriteContainer(resource);
@@ -4,6 +4,7 @@
 using System.Net.Sockets;
 using Aspire.Hosting.ApplicationModel;
 using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -73,6 +74,39 @@ public static IResourceBuilder<MySqlDatabaseResource> AddDatabase(this IResource
                                          .WithManifestPublishingCallback(context => WriteMySqlDatabaseToManifest(context, mySqlDatabase));
     }
 
+    /// <summary>
+    /// Adds a named volume for the data folder to a MySQL container resource. The volume is mounted at <c>/var/lib/mysql</c> in the container.
+    /// </summary>
+    /// <param name="builder">The MySQL container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{MySqlContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>MYSQL_ROOT_PASSWORD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddMySqlContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<MySqlContainerResource> WithDataVolume(this IResourceBuilder<MySqlContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/var/lib/mysql", VolumeMountType.Named);
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to a MySQL container resource. The source is mounted at <c>/var/lib/mysql</c> in the container.
+    /// </summary>
+    /// <param name="builder">The MySQL container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{MySqlContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>MYSQL_ROOT_PASSWORD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddMySqlContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<MySqlContainerResource> WithDataBindMount(this IResourceBuilder<MySqlContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/var/lib/mysql", VolumeMountType.Bind);
+    }
+
     private static void WriteMySqlContainerToManifest(ManifestPublishingContext context)
     {
         context.Writer.WriteString("type", "mysql.server.v0");
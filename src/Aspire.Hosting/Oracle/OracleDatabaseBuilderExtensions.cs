@@ -4,6 +4,7 @@
 using System.Net.Sockets;
 using Aspire.Hosting.ApplicationModel;
 using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -15,7 +16,7 @@ public static class OracleDatabaseBuilderExtensions
     private const string PasswordEnvVarName = "ORACLE_PWD";
 
     /// <summary>
-    /// Adds a Oracle Database container to the application model. The default image is "database/free" and the tag is "latest".
+    /// Adds an Oracle Database container to the application model. The default image is "database/free" and the tag is "latest".
     /// </summary>
     /// <param name="builder">The <see cref="IDistributedApplicationBuilder"/>.</param>
     /// <param name="name">The name of the resource. This name will be used as the connection string name when referenced in a dependency.</param>
@@ -44,7 +45,7 @@ public static IResourceBuilder<OracleDatabaseContainerResource> AddOracleDatabas
     }
 
     /// <summary>
-    /// Adds a Oracle Database resource to the application model. A container is used for local development.
+    /// Adds an Oracle Database resource to the application model. A container is used for local development.
     /// </summary>
     /// <param name="builder">The <see cref="IDistributedApplicationBuilder"/>.</param>
     /// <param name="name">The name of the resource. This name will be used as the connection string name when referenced in a dependency.</param>
@@ -61,7 +62,7 @@ public static IResourceBuilder<OracleDatabaseServerResource> AddOracleDatabase(t
     }
 
     /// <summary>
-    /// Adds a Oracle Database database to the application model.
+    /// Adds an Oracle Database database to the application model.
     /// </summary>
     /// <param name="builder">The Oracle Database server resource builder.</param>
     /// <param name="name">The name of the resource. This name will be used as the connection string name when referenced in a dependency.</param>
@@ -73,6 +74,39 @@ public static IResourceBuilder<OracleDatabaseResource> AddDatabase(this IResourc
                                          .WithManifestPublishingCallback(context => WriteOracleDatabaseToManifest(context, oracleDatabase));
     }
 
+    /// <summary>
+    /// Adds a named volume for the data folder to an Oracle Database container resource. The volume is mounted at <c>/opt/oracle/oradata</c> in the container.
+    /// </summary>
+    /// <param name="builder">The Oracle Database container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{OracleDatabaseContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>ORACLE_PWD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddOracleDatabaseContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<OracleDatabaseContainerResource> WithDataVolume(this IResourceBuilder<OracleDatabaseContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/opt/oracle/oradata", VolumeMountType.Named);
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to an Oracle Database container resource. The source is mounted at <c>/opt/oracle/oradata</c> in the container.
+    /// </summary>
+    /// <param name="builder">The Oracle Database container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{OracleDatabaseContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>ORACLE_PWD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddOracleDatabaseContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<OracleDatabaseContainerResource> WithDataBindMount(this IResourceBuilder<OracleDatabaseContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/opt/oracle/oradata", VolumeMountType.Bind);
+    }
+
     private static void WriteOracleDatabaseContainerToManifest(ManifestPublishingContext context)
     {
         context.Writer.WriteString("type", "oracle.server.v0");
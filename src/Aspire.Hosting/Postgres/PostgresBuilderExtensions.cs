@@ -6,6 +6,7 @@
 using Aspire.Hosting.Lifecycle;
 using Aspire.Hosting.Postgres;
 using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -108,6 +109,39 @@ public static IResourceBuilder<PostgresDatabaseResource> AddDatabase(this IResou
         return builder;
     }
 
+    /// <summary>
+    /// Adds a named volume for the data folder to a PostgreSQL container resource. The volume is mounted at <c>/var/lib/postgresql/data</c> in the container.
+    /// </summary>
+    /// <param name="builder">The PostgreSQL container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{PostgresContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>POSTGRES_PASSWORD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddPostgresContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<PostgresContainerResource> WithDataVolume(this IResourceBuilder<PostgresContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/var/lib/postgresql/data", VolumeMountType.Named);
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to a PostgreSQL container resource. The source is mounted at <c>/var/lib/postgresql/data</c> in the container.
+    /// </summary>
+    /// <param name="builder">The PostgreSQL container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{PostgresContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>POSTGRES_PASSWORD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddPostgresContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<PostgresContainerResource> WithDataBindMount(this IResourceBuilder<PostgresContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/var/lib/postgresql/data", VolumeMountType.Bind);
+    }
+
     private static void SetPgAdminEnviromentVariables(EnvironmentCallbackContext context)
     {
         // Disables pgAdmin authentication.
@@ -0,0 +1,45 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+using Aspire.Hosting.ApplicationModel;
+using Aspire.Hosting.Lifecycle;
+using Microsoft.Extensions.Options;
+
+namespace Aspire.Hosting.Publishing;
+
+internal sealed class ExternalEndpointsMutationHook(IOptions<PublishingOptions> publishingOptions) : IDistributedApplicationLifecycleHook
+{
+    private readonly IOptions<PublishingOptions> _publishingOptions = publishingOptions;
+
+    public Task BeforeStartAsync(DistributedApplicationModel appModel, CancellationToken cancellationToken = default)
+    {
+        // External endpoints only affect the manifest. Locally, projects only get the endpoints from
+        // their launch profile, so names that are valid for publishing may not exist here.
+        if (_publishingOptions.Value.Publisher != "manifest")
+        {
+            return Task.CompletedTask;
+        }
+
+        foreach (var resource in appModel.Resources)
+        {
+            if (!resource.TryGetLastAnnotation<ExternalEndpointsAnnotation>(out var externalEndpointsAnnotation))
+            {
+                continue;
+            }
+
+            // This runs after the hook that adds the default http and https endpoints to projects,
+            // so every endpoint the resource will have in the manifest is known by now.
+            var endpoints = resource.Annotations.OfType<EndpointAnnotation>().ToArray();
+
+            foreach (var endpointName in externalEndpointsAnnotation.EndpointNames)
+            {
+                var endpoint = endpoints.SingleOrDefault(e => e.Name == endpointName)
+                    ?? throw new DistributedApplicationException($"Resource '{resource.Name}' does not have an endpoint named '{endpointName}' to expose externally.");
+
+                endpoint.IsExternal = true;
+            }
+        }
+
+        return Task.CompletedTask;
+    }
+}
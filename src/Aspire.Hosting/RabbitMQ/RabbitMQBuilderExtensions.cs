@@ -4,6 +4,7 @@
 using System.Net.Sockets;
 using Aspire.Hosting.ApplicationModel;
 using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -63,6 +64,50 @@ public static IResourceBuilder<RabbitMQServerResource> AddRabbitMQ(this IDistrib
                        .WithEnvironment("RABBITMQ_DEFAULT_PASS", () => rabbitMq.Password);
     }
 
+    /// <summary>
+    /// Adds a named volume for the data folder to a RabbitMQ container resource. The volume is mounted at <c>/var/lib/rabbitmq</c> in the container.
+    /// </summary>
+    /// <param name="builder">The RabbitMQ container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{RabbitMQContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>RABBITMQ_DEFAULT_PASS</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddRabbitMQContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// The node name is set to <c>rabbit@localhost</c> so the data directory is the same across runs.
+    /// </remarks>
+    public static IResourceBuilder<RabbitMQContainerResource> WithDataVolume(this IResourceBuilder<RabbitMQContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/var/lib/rabbitmq", VolumeMountType.Named)
+                      .WithPersistentNodeName();
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to a RabbitMQ container resource. The source is mounted at <c>/var/lib/rabbitmq</c> in the container.
+    /// </summary>
+    /// <param name="builder">The RabbitMQ container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{RabbitMQContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>RABBITMQ_DEFAULT_PASS</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddRabbitMQContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// The node name is set to <c>rabbit@localhost</c> so the data directory is the same across runs.
+    /// </remarks>
+    public static IResourceBuilder<RabbitMQContainerResource> WithDataBindMount(this IResourceBuilder<RabbitMQContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/var/lib/rabbitmq", VolumeMountType.Bind)
+                      .WithPersistentNodeName();
+    }
+
+    // RabbitMQ stores its data under a directory named after the node, which defaults to rabbit@<hostname>. The container
+    // hostname changes on every run, so pin the node name to keep using the same data directory.
+    private static IResourceBuilder<RabbitMQContainerResource> WithPersistentNodeName(this IResourceBuilder<RabbitMQContainerResource> builder)
+    {
+        return builder.WithEnvironment("RABBITMQ_NODENAME", "rabbit@localhost");
+    }
+
     private static void WriteRabbitMQServerToManifest(ManifestPublishingContext context)
     {
         context.Writer.WriteString("type", "rabbitmq.server.v0");
@@ -6,6 +6,7 @@
 using Aspire.Hosting.Lifecycle;
 using Aspire.Hosting.Publishing;
 using Aspire.Hosting.Redis;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -65,6 +66,31 @@ public static IResourceBuilder<RedisResource> AddRedis(this IDistributedApplicat
         return builder;
     }
 
+    /// <summary>
+    /// Adds a named volume for the data folder to a Redis container resource. The volume is mounted at <c>/data</c> in the container.
+    /// </summary>
+    /// <param name="builder">The Redis container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{RedisContainerResource}"/>.</returns>
+    public static IResourceBuilder<RedisContainerResource> WithDataVolume(this IResourceBuilder<RedisContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/data", VolumeMountType.Named);
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to a Redis container resource. The source is mounted at <c>/data</c> in the container.
+    /// </summary>
+    /// <param name="builder">The Redis container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{RedisContainerResource}"/>.</returns>
+    public static IResourceBuilder<RedisContainerResource> WithDataBindMount(this IResourceBuilder<RedisContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/data", VolumeMountType.Bind);
+    }
+
     private static void WriteRedisResourceToManifest(ManifestPublishingContext context)
     {
         context.Writer.WriteString("type", "redis.v0");
@@ -4,6 +4,7 @@
 using System.Net.Sockets;
 using Aspire.Hosting.ApplicationModel;
 using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Utils;
 
 namespace Aspire.Hosting;
 
@@ -105,4 +106,37 @@ public static IResourceBuilder<SqlServerDatabaseResource> AddDatabase(this IReso
         return builder.ApplicationBuilder.AddResource(sqlServerDatabase)
                                          .WithManifestPublishingCallback(context => WriteSqlServerDatabaseToManifest(context, sqlServerDatabase));
     }
+
+    /// <summary>
+    /// Adds a named volume for the data folder to a SQL Server container resource. The volume is mounted at <c>/var/opt/mssql</c> in the container.
+    /// </summary>
+    /// <param name="builder">The SQL Server container resource builder.</param>
+    /// <param name="name">The name of the volume. Defaults to <c>{applicationName}-{resourceName}-data</c> if not specified.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{SqlServerContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>MSSQL_SA_PASSWORD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddSqlServerContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<SqlServerContainerResource> WithDataVolume(this IResourceBuilder<SqlServerContainerResource> builder, string? name = null)
+    {
+        return builder.WithVolumeMount(name ?? VolumeNameGenerator.CreateVolumeName(builder, "data"), "/var/opt/mssql", VolumeMountType.Named);
+    }
+
+    /// <summary>
+    /// Adds a bind mount for the data folder to a SQL Server container resource. The source is mounted at <c>/var/opt/mssql</c> in the container.
+    /// </summary>
+    /// <param name="builder">The SQL Server container resource builder.</param>
+    /// <param name="source">The source directory on the host. Relative paths are resolved against the AppHost directory.</param>
+    /// <returns>A reference to the <see cref="IResourceBuilder{SqlServerContainerResource}"/>.</returns>
+    /// <remarks>
+    /// The container only reads <c>MSSQL_SA_PASSWORD</c> when it initializes an empty data directory, so pass a fixed <c>password</c> to
+    /// <see cref="AddSqlServerContainer"/> when persisting data. Otherwise a new random password is generated on each run and no longer matches the stored data.
+    /// </remarks>
+    public static IResourceBuilder<SqlServerContainerResource> WithDataBindMount(this IResourceBuilder<SqlServerContainerResource> builder, string source)
+    {
+        ArgumentException.ThrowIfNullOrEmpty(source);
+
+        source = PathNormalizer.NormalizePathForCurrentPlatform(Path.Combine(builder.ApplicationBuilder.AppHostDirectory, source));
+        return builder.WithVolumeMount(source, "/var/opt/mssql", VolumeMountType.Bind);
+    }
 }
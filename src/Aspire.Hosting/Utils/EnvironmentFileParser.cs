@@ -0,0 +1,151 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+using System.Text;
+
+namespace Aspire.Hosting.Utils;
+
+/// <summary>
+/// Parses environment files containing one <c>NAME=VALUE</c> pair per line.
+/// </summary>
+/// <remarks>
+/// This is a subset of the dotenv format: bare <c>NAME</c> lines and <c>${VAR}</c> interpolation are not supported.
+/// </remarks>
+internal static class EnvironmentFileParser
+{
+    public static Dictionary<string, string> Parse(string path)
+    {
+        using var reader = new StreamReader(path);
+        return Parse(reader, path);
+    }
+
+    public static Dictionary<string, string> Parse(TextReader reader, string path)
+    {
+        var variables = new Dictionary<string, string>();
+        var lineNumber = 0;
+
+        while (reader.ReadLine() is { } line)
+        {
+            lineNumber++;
+
+            var trimmed = line.Trim();
+            if (trimmed.Length == 0 || trimmed.StartsWith('#'))
+            {
+                continue;
+            }
+
+            if (trimmed.Length > "export".Length && trimmed.StartsWith("export", StringComparison.Ordinal) && char.IsWhiteSpace(trimmed["export".Length]))
+            {
+                trimmed = trimmed["export".Length..].TrimStart();
+            }
+
+            var separatorIndex = trimmed.IndexOf('=');
+            if (separatorIndex <= 0)
+            {
+                throw new DistributedApplicationException($"Environment file '{path}' line {lineNumber}: expected 'NAME=VALUE'.");
+            }
+
+            var name = trimmed[..separatorIndex].TrimEnd();
+            if (name.Any(char.IsWhiteSpace))
+            {
+                throw new DistributedApplicationException($"Environment file '{path}' line {lineNumber}: variable name '{name}' must not contain whitespace.");
+            }
+
+            variables[name] = ParseValue(trimmed[(separatorIndex + 1)..], path, lineNumber);
+        }
+
+        return variables;
+    }
+
+    private static string ParseValue(string rawValue, string path, int lineNumber)
+    {
+        var value = rawValue.TrimStart();
+
+        // A '#' that was preceded by whitespace after the '=' starts a comment, leaving the value empty.
+        if (value.Length == 0 || (value[0] == '#' && value.Length < rawValue.Length))
+        {
+            return string.Empty;
+        }
+
+        var quote = value[0];
+        if (quote is '"' or '\'')
+        {
+            var closingIndex = FindClosingQuote(value, quote);
+            if (closingIndex < 0)
+            {
+                throw new DistributedApplicationException($"Environment file '{path}' line {lineNumber}: missing closing {quote} quote.");
+            }
+
+            var remainder = value[(closingIndex + 1)..].TrimStart();
+            if (remainder.Length > 0 && !remainder.StartsWith('#'))
+            {
+                throw new DistributedApplicationException($"Environment file '{path}' line {lineNumber}: unexpected characters after closing quote.");
+            }
+
+            var quoted = value[1..closingIndex];
+
+            // Single quoted values are taken literally, double quoted values support escape sequences.
+            return quote == '"' ? Unescape(quoted) : quoted;
+        }
+
+        // An unquoted value ends at the first comment that is preceded by whitespace.
+        for (var i = 1; i < value.Length; i++)
+        {
+            if (value[i] == '#' && char.IsWhiteSpace(value[i - 1]))
+            {
+                return value[..i].TrimEnd();
+            }
+        }
+
+        return value.TrimEnd();
+    }
+
+    private static int FindClosingQuote(string value, char quote)
+    {
+        for (var i = 1; i < value.Length; i++)
+        {
+            if (quote == '"' && value[i] == '\\')
+            {
+                i++;
+                continue;
+            }
+
+            if (value[i] == quote)
+            {
+                return i;
+            }
+        }
+
+        return -1;
+    }
+
+    private static string Unescape(string value)
+    {
+        if (!value.Contains('\\'))
+        {
+            return value;
+        }
+
+        var builder = new StringBuilder(value.Length);
+        for (var i = 0; i < value.Length; i++)
+        {
+            if (value[i] == '\\' && i + 1 < value.Length)
+            {
+                i++;
+                builder.Append(value[i] switch
+                {
+                    'n' => '\n',
+                    'r' => '\r',
+                    't' => '\t',
+                    var c => c
+                });
+            }
+            else
+            {
+                builder.Append(value[i]);
+            }
+        }
+
+        return builder.ToString();
+    }
+}
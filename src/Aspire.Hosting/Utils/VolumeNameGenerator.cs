@@ -0,0 +1,28 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+using Aspire.Hosting.ApplicationModel;
+
+namespace Aspire.Hosting.Utils;
+
+internal static class VolumeNameGenerator
+{
+    /// <summary>
+    /// Creates a volume name of the form <c>{applicationName}-{resourceName}-{suffix}</c>. The application name is
+    /// included so that resources with the same name in different AppHosts on the same machine don't share a volume.
+    /// </summary>
+    public static string CreateVolumeName<T>(IResourceBuilder<T> builder, string suffix) where T : IResource
+    {
+        var applicationName = Sanitize(builder.ApplicationBuilder.Environment.ApplicationName);
+        return $"{applicationName}-{builder.Resource.Name}-{suffix}";
+    }
+
+    // Docker volume names may only contain [a-zA-Z0-9_.-] and must start with a letter or digit.
+    private static string Sanitize(string name)
+    {
+        var chars = name.Select(c => char.IsAsciiLetterOrDigit(c) || c is '_' or '.' or '-' ? c : '_').ToArray();
+        var sanitized = new string(chars).TrimStart('_', '.', '-');
+
+        return sanitized.Length > 0 ? sanitized : "aspire";
+    }
+}
@@ -0,0 +1,38 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+using Microsoft.Extensions.DependencyInjection;
+using Xunit;
+
+namespace Aspire.Hosting.Tests;
+
+public class ExecutableResourceTests
+{
+    [Fact]
+    public void AddExecutableWithEmptyWorkingDirectoryUsesAppHostDirectory()
+    {
+        var appBuilder = DistributedApplication.CreateBuilder();
+        appBuilder.AddExecutable("executable", "command", "");
+
+        var app = appBuilder.Build();
+
+        var appModel = app.Services.GetRequiredService<DistributedApplicationModel>();
+        var executableResource = Assert.Single(appModel.GetExecutableResources());
+
+        Assert.Equal(Path.GetFullPath(appBuilder.AppHostDirectory), executableResource.WorkingDirectory);
+    }
+
+    [Fact]
+    public void AddExecutableWithRelativeWorkingDirectoryResolvesAgainstAppHostDirectory()
+    {
+        var appBuilder = DistributedApplication.CreateBuilder();
+        appBuilder.AddExecutable("executable", "command", "tools");
+
+        var app = appBuilder.Build();
+
+        var appModel = app.Services.GetRequiredService<DistributedApplicationModel>();
+        var executableResource = Assert.Single(appModel.GetExecutableResources());
+
+        Assert.Equal(Path.GetFullPath(Path.Combine(appBuilder.AppHostDirectory, "tools")), executableResource.WorkingDirectory);
+    }
+}
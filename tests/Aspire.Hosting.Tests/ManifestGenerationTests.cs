@@ -148,6 +148,55 @@ public void EnsureContainerWithArgsEmitsContainerArgs()
             arg => Assert.Equal("more", arg.GetString()));
     }
 
+    [Fact]
+    public void EnsureContainerWithArgsCalledTwiceAppendsArgs()
+    {
+        var program = CreateTestProgramJsonDocumentManifestPublisher();
+
+        program.AppBuilder.AddContainer("grafana", "grafana/grafana")
+                          .WithArgs("test")
+                          .WithArgs("arg2", "more");
+
+        // Build AppHost so that publisher can be resolved.
+        program.Build();
+        var publisher = program.GetManifestPublisher();
+
+        program.Run();
+
+        var resources = publisher.ManifestDocument.RootElement.GetProperty("resources");
+
+        var grafana = resources.GetProperty("grafana");
+        var args = grafana.GetProperty("args");
+        Assert.Collection(args.EnumerateArray(),
+            arg => Assert.Equal("test", arg.GetString()),
+            arg => Assert.Equal("arg2", arg.GetString()),
+            arg => Assert.Equal("more", arg.GetString()));
+    }
+
+    [Fact]
+    public void EnsureExecutableWithArgsContainingSpacesEmitsSingleArgs()
+    {
+        var program = CreateTestProgramJsonDocumentManifestPublisher();
+
+        program.AppBuilder.AddExecutable("program", "run program", "c:/")
+                          .WithArgs("--message", "hello world", "\"already quoted\"");
+
+        // Build AppHost so that publisher can be resolved.
+        program.Build();
+        var publisher = program.GetManifestPublisher();
+
+        program.Run();
+
+        var resources = publisher.ManifestDocument.RootElement.GetProperty("resources");
+
+        var resource = resources.GetProperty("program");
+        var args = resource.GetProperty("args");
+        Assert.Collection(args.EnumerateArray(),
+            arg => Assert.Equal("--message", arg.GetString()),
+            arg => Assert.Equal("hello world", arg.GetString()),
+            arg => Assert.Equal("\"already quoted\"", arg.GetString()));
+    }
+
     [Theory]
     [InlineData(new string[] { "args1", "args2" }, new string[] { "withArgs1", "withArgs2" })]
     [InlineData(new string[] { }, new string[] { "withArgs1", "withArgs2" })]
@@ -33,4 +33,29 @@ public void TestSpecialCharactersAndEscapeForPassword(string password, string ex
         Assert.Equal(password, builder.Password);
         Assert.Equal(expectedConnectionString, connectionString);
     }
+
+    [Fact]
+    public void WithDataVolumeAddsNamedVolumeAtDataDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var mongo = builder.AddMongoDBContainer("mymongo").WithDataVolume();
+
+        var volume = Assert.Single(mongo.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal($"{builder.Environment.ApplicationName}-mymongo-data", volume.Source);
+        Assert.Equal("/data/db", volume.Target);
+        Assert.Equal(VolumeMountType.Named, volume.Type);
+        Assert.False(volume.IsReadOnly);
+    }
+
+    [Fact]
+    public void WithDataBindMountResolvesSourceRelativeToAppHostDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var mongo = builder.AddMongoDBContainer("mymongo").WithDataBindMount("data");
+
+        var volume = Assert.Single(mongo.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal(Path.Combine(builder.AppHostDirectory, "data"), volume.Source);
+        Assert.Equal("/data/db", volume.Target);
+        Assert.Equal(VolumeMountType.Bind, volume.Type);
+    }
 }
@@ -28,4 +28,29 @@ public void TestEscapeSequencesForPassword(string password, string expectedConne
         Assert.Equal(password, builder.Password);
         Assert.Equal(expectedConnectionString, actualConnectionString);
     }
+
+    [Fact]
+    public void WithDataVolumeAddsNamedVolumeAtDataDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var mysql = builder.AddMySqlContainer("mymysql").WithDataVolume();
+
+        var volume = Assert.Single(mysql.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal($"{builder.Environment.ApplicationName}-mymysql-data", volume.Source);
+        Assert.Equal("/var/lib/mysql", volume.Target);
+        Assert.Equal(VolumeMountType.Named, volume.Type);
+        Assert.False(volume.IsReadOnly);
+    }
+
+    [Fact]
+    public void WithDataBindMountResolvesSourceRelativeToAppHostDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var mysql = builder.AddMySqlContainer("mymysql").WithDataBindMount("data");
+
+        var volume = Assert.Single(mysql.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal(Path.Combine(builder.AppHostDirectory, "data"), volume.Source);
+        Assert.Equal("/var/lib/mysql", volume.Target);
+        Assert.Equal(VolumeMountType.Bind, volume.Type);
+    }
 }
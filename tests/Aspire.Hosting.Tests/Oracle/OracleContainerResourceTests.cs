@@ -28,4 +28,29 @@ public void TestEscapeSequencesForPassword(string password, string expectedConne
         Assert.Equal(password, builder.Password);
         Assert.Equal(expectedConnectionString, actualConnectionString);
     }
+
+    [Fact]
+    public void WithDataVolumeAddsNamedVolumeAtDataDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var oracle = builder.AddOracleDatabaseContainer("myoracle").WithDataVolume();
+
+        var volume = Assert.Single(oracle.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal($"{builder.Environment.ApplicationName}-myoracle-data", volume.Source);
+        Assert.Equal("/opt/oracle/oradata", volume.Target);
+        Assert.Equal(VolumeMountType.Named, volume.Type);
+        Assert.False(volume.IsReadOnly);
+    }
+
+    [Fact]
+    public void WithDataBindMountResolvesSourceRelativeToAppHostDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var oracle = builder.AddOracleDatabaseContainer("myoracle").WithDataBindMount("data");
+
+        var volume = Assert.Single(oracle.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal(Path.Combine(builder.AppHostDirectory, "data"), volume.Source);
+        Assert.Equal("/opt/oracle/oradata", volume.Target);
+        Assert.Equal(VolumeMountType.Bind, volume.Type);
+    }
 }
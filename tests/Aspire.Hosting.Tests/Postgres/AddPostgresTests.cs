@@ -236,6 +236,31 @@ public void AddDatabaseToPostgresAddsAnnotationMetadata()
             });
     }
 
+    [Fact]
+    public void WithDataVolumeAddsNamedVolumeAtDataDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var postgres = builder.AddPostgresContainer("mypostgres").WithDataVolume();
+
+        var volume = Assert.Single(postgres.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal($"{builder.Environment.ApplicationName}-mypostgres-data", volume.Source);
+        Assert.Equal("/var/lib/postgresql/data", volume.Target);
+        Assert.Equal(VolumeMountType.Named, volume.Type);
+        Assert.False(volume.IsReadOnly);
+    }
+
+    [Fact]
+    public void WithDataBindMountResolvesSourceRelativeToAppHostDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var postgres = builder.AddPostgresContainer("mypostgres").WithDataBindMount("data");
+
+        var volume = Assert.Single(postgres.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal(Path.Combine(builder.AppHostDirectory, "data"), volume.Source);
+        Assert.Equal("/var/lib/postgresql/data", volume.Target);
+        Assert.Equal(VolumeMountType.Bind, volume.Type);
+    }
+
     [Fact]
     public void WithPgAdminAddsContainer()
     {
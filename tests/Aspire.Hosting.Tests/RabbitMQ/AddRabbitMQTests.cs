@@ -0,0 +1,55 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+using Xunit;
+
+namespace Aspire.Hosting.Tests.RabbitMQ;
+
+public class AddRabbitMQTests
+{
+    [Fact]
+    public void WithDataVolumeAddsNamedVolumeAtDataDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var rabbitmq = builder.AddRabbitMQContainer("myrabbitmq").WithDataVolume();
+
+        var volume = Assert.Single(rabbitmq.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal($"{builder.Environment.ApplicationName}-myrabbitmq-data", volume.Source);
+        Assert.Equal("/var/lib/rabbitmq", volume.Target);
+        Assert.Equal(VolumeMountType.Named, volume.Type);
+        Assert.False(volume.IsReadOnly);
+    }
+
+    [Fact]
+    public void WithDataBindMountResolvesSourceRelativeToAppHostDirectory()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var rabbitmq = builder.AddRabbitMQContainer("myrabbitmq").WithDataBindMount("data");
+
+        var volume = Assert.Single(rabbitmq.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal(Path.Combine(builder.AppHostDirectory, "data"), volume.Source);
+        Assert.Equal("/var/lib/rabbitmq", volume.Target);
+        Assert.Equal(VolumeMountType.Bind, volume.Type);
+    }
+
+    [Theory]
+    [InlineData(true)]
+    [InlineData(false)]
+    public void WithDataVolumeOrBindMountPinsNodeName(bool useVolume)
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var rabbitmq = builder.AddRabbitMQContainer("myrabbitmq");
+        _ = useVolume ? rabbitmq.WithDataVolume() : rabbitmq.WithDataBindMount("data");
+
+        // Call environment variable callbacks.
+        var config = new Dictionary<string, string>();
+        var context = new EnvironmentCallbackContext("dcp", config);
+
+        foreach (var annotation in rabbitmq.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>())
+        {
+            annotation.Callback(context);
+        }
+
+        Assert.Equal("rabbit@localhost", config["RABBITMQ_NODENAME"]);
+    }
+}
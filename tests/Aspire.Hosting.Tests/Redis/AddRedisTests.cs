@@ -133,6 +133,18 @@ public async Task SingleRedisInstanceProducesCorrectRedisHostsVariable()
         Assert.Equal("myredis1:host.docker.internal:5001:0", context.EnvironmentVariables["REDIS_HOSTS"]);
     }
 
+    [Fact]
+    public void WithDataVolumeUsesProvidedVolumeName()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+        var redis = builder.AddRedisContainer("myRedis").WithDataVolume("redis-cache");
+
+        var volume = Assert.Single(redis.Resource.Annotations.OfType<VolumeMountAnnotation>());
+        Assert.Equal("redis-cache", volume.Source);
+        Assert.Equal("/data", volume.Target);
+        Assert.Equal(VolumeMountType.Named, volume.Type);
+    }
+
     [Fact]
     public async Task MultipleRedisInstanceProducesCorrectRedisHostsVariable()
     {
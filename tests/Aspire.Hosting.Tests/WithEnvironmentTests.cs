@@ -124,5 +124,251 @@ public void ComplexEnvironmentCallbackPopulatesValueWhenCalled()
         Assert.Equal(1, servicesKeysCount);
         Assert.Contains(config, kvp => kvp.Key == "myName" && kvp.Value == "value2");
     }
+
+    [Fact]
+    public void WithoutEnvironmentBlanksVariableForDcpExecutables()
+    {
+        var testProgram = CreateTestProgram();
+
+        testProgram.ServiceABuilder.WithEnvironment("myName", "value")
+                                   .WithEnvironment("otherName", "otherValue")
+                                   .WithoutEnvironment("myName");
+
+        testProgram.Build();
+
+        // Call environment variable callbacks.
+        var annotations = testProgram.ServiceABuilder.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+        var config = new Dictionary<string, string>();
+        var context = new EnvironmentCallbackContext("dcp", config);
+
+        foreach (var annotation in annotations)
+        {
+            annotation.Callback(context);
+        }
+
+        Assert.Contains(config, kvp => kvp.Key == "myName" && kvp.Value == "");
+        Assert.Contains(config, kvp => kvp.Key == "otherName" && kvp.Value == "otherValue");
+    }
+
+    [Fact]
+    public void WithoutEnvironmentRemovesVariableFromDcpContainers()
+    {
+        var builder = DistributedApplication.CreateBuilder();
+
+        var container = builder.AddContainer("container", "image")
+                               .WithEnvironment("myName", "value")
+                               .WithoutEnvironment("myName");
+
+        // Call environment variable callbacks.
+        var annotations = container.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+        var config = new Dictionary<string, string>();
+        var context = new EnvironmentCallbackContext("dcp", config);
+
+        foreach (var annotation in annotations)
+        {
+            annotation.Callback(context);
+        }
+
+        Assert.DoesNotContain("myName", config.Keys);
+    }
+
+    [Fact]
+    public void WithoutEnvironmentRemovesVariableFromManifest()
+    {
+        var testProgram = CreateTestProgram();
+
+        testProgram.ServiceABuilder.WithEnvironment("myName", "value")
+                                   .WithoutEnvironment("myName");
+
+        testProgram.Build();
+
+        // Call environment variable callbacks.
+        var annotations = testProgram.ServiceABuilder.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+        var config = new Dictionary<string, string>();
+        var context = new EnvironmentCallbackContext("manifest", config);
+
+        foreach (var annotation in annotations)
+        {
+            annotation.Callback(context);
+        }
+
+        Assert.DoesNotContain("myName", config.Keys);
+    }
+
+    [Fact]
+    public void WithoutEnvironmentKeepsBlankedVariableFromBeingInherited()
+    {
+        var testProgram = CreateTestProgram();
+
+        testProgram.ServiceABuilder.WithoutEnvironment("ASPNETCORE_ENVIRONMENT");
+
+        testProgram.Build();
+
+        // Call environment variable callbacks.
+        var annotations = testProgram.ServiceABuilder.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+        // Simulate the variables DCP blanks when a project has no launch profile.
+        var config = new Dictionary<string, string> { ["ASPNETCORE_ENVIRONMENT"] = "" };
+        var context = new EnvironmentCallbackContext("dcp", config);
+
+        foreach (var annotation in annotations)
+        {
+            annotation.Callback(context);
+        }
+
+        Assert.Contains(config, kvp => kvp.Key == "ASPNETCORE_ENVIRONMENT" && kvp.Value == "");
+    }
+
+    [Fact]
+    public void WithoutEnvironmentDoesNotRemoveVariableAddedLater()
+    {
+        var testProgram = CreateTestProgram();
+
+        testProgram.ServiceABuilder.WithEnvironment("myName", "value")
+                                   .WithoutEnvironment("myName")
+                                   .WithEnvironment("myName", "value2");
+
+        testProgram.Build();
+
+        // Call environment variable callbacks.
+        var annotations = testProgram.ServiceABuilder.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+        var config = new Dictionary<string, string>();
+        var context = new EnvironmentCallbackContext("dcp", config);
+
+        foreach (var annotation in annotations)
+        {
+            annotation.Callback(context);
+        }
+
+        Assert.Contains(config, kvp => kvp.Key == "myName" && kvp.Value == "value2");
+    }
+
+    [Fact]
+    public void EnvironmentFilePopulatesVariables()
+    {
+        var testProgram = CreateTestProgram();
+
+        var envFile = Path.GetTempFileName();
+        try
+        {
+            File.WriteAllLines(envFile,
+            [
+                "# Database settings",
+                "DB_HOST=localhost",
+                "export DB_PORT=5432",
+                "export\tDB_USER=admin",
+                "",
+                "GREETING=\"hello world\" # trailing comment",
+                "LITERAL='keep \\n as is'",
+                "ESCAPED=\"line1\\nline2\"",
+                "UNQUOTED=value # comment",
+                "TABBED=value\t# comment",
+                "EMPTY=",
+                "EMPTY_WITH_COMMENT= # note",
+                "NOT_A_COMMENT=#value"
+            ]);
+
+            testProgram.ServiceABuilder.WithEnvironmentFile(envFile);
+
+            testProgram.Build();
+
+            // Call environment variable callbacks.
+            var annotations = testProgram.ServiceABuilder.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+            var config = new Dictionary<string, string>();
+            var context = new EnvironmentCallbackContext("dcp", config);
+
+            foreach (var annotation in annotations)
+            {
+                annotation.Callback(context);
+            }
+
+            Assert.Equal("localhost", config["DB_HOST"]);
+            Assert.Equal("5432", config["DB_PORT"]);
+            Assert.Equal("hello world", config["GREETING"]);
+            Assert.Equal("keep \\n as is", config["LITERAL"]);
+            Assert.Equal("line1\nline2", config["ESCAPED"]);
+            Assert.Equal("value", config["UNQUOTED"]);
+            Assert.Equal("value", config["TABBED"]);
+            Assert.Equal("admin", config["DB_USER"]);
+            Assert.Equal("", config["EMPTY"]);
+            Assert.Equal("", config["EMPTY_WITH_COMMENT"]);
+            Assert.Equal("#value", config["NOT_A_COMMENT"]);
+            Assert.DoesNotContain(config.Keys, k => k.StartsWith('#'));
+        }
+        finally
+        {
+            File.Delete(envFile);
+        }
+    }
+
+    [Fact]
+    public void EnvironmentFileWithInvalidLineThrowsWithLineNumber()
+    {
+        var testProgram = CreateTestProgram();
+
+        var envFile = Path.GetTempFileName();
+        try
+        {
+            File.WriteAllLines(envFile, ["VALID=1", "# comment", "NOT_AN_ASSIGNMENT"]);
+
+            var ex = Assert.Throws<DistributedApplicationException>(() => testProgram.ServiceABuilder.WithEnvironmentFile(envFile));
+            Assert.Equal($"Environment file '{envFile}' line 3: expected 'NAME=VALUE'.", ex.Message);
+        }
+        finally
+        {
+            File.Delete(envFile);
+        }
+    }
+
+    [Fact]
+    public void EnvironmentFileRelativePathResolvesAgainstAppHostDirectory()
+    {
+        var testProgram = CreateTestProgram();
+
+        var fileName = $"{Guid.NewGuid():N}.env";
+        var envFile = Path.Combine(testProgram.AppBuilder.AppHostDirectory, fileName);
+        try
+        {
+            File.WriteAllLines(envFile, ["FROM_APPHOST=1"]);
+
+            testProgram.ServiceABuilder.WithEnvironmentFile(fileName);
+
+            testProgram.Build();
+
+            // Call environment variable callbacks.
+            var annotations = testProgram.ServiceABuilder.Resource.Annotations.OfType<EnvironmentCallbackAnnotation>();
+
+            var config = new Dictionary<string, string>();
+            var context = new EnvironmentCallbackContext("dcp", config);
+
+            foreach (var annotation in annotations)
+            {
+                annotation.Callback(context);
+            }
+
+            Assert.Equal("1", config["FROM_APPHOST"]);
+        }
+        finally
+        {
+            File.Delete(envFile);
+        }
+    }
+
+    [Fact]
+    public void EnvironmentFileThatDoesNotExistThrows()
+    {
+        var testProgram = CreateTestProgram();
+
+        var envFile = Path.Combine(Path.GetTempPath(), Guid.NewGuid().ToString("N"), ".env");
+
+        var ex = Assert.Throws<DistributedApplicationException>(() => testProgram.ServiceABuilder.WithEnvironmentFile(envFile));
+        Assert.Equal($"Environment file '{envFile}' does not exist.", ex.Message);
+    }
+
     private static TestProgram CreateTestProgram(string[]? args = null) => TestProgram.Create<WithReferenceTests>(args);
 }
@@ -0,0 +1,110 @@
+// Licensed to the .NET Foundation under one or more agreements.
+// The .NET Foundation licenses this file to you under the MIT license.
+
+using Aspire.Hosting.Publishing;
+using Aspire.Hosting.Tests.Helpers;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Options;
+using Xunit;
+
+namespace Aspire.Hosting.Tests;
+
+public class WithExternalEndpointsTests
+{
+    [Fact]
+    public void OnlyNamedEndpointsAreMarkedExternal()
+    {
+        var testProgram = CreateTestProgram(["--publisher", "manifest"]);
+        testProgram.ServiceABuilder.WithEndpoint(9999, scheme: "tcp", name: "admin");
+        testProgram.ServiceABuilder.WithExternalEndpoints("https");
+
+        // Block DCP from actually starting anything up as we don't need it for this test.
+        testProgram.AppBuilder.Services.AddKeyedSingleton<IDistributedApplicationPublisher, NoopPublisher>("manifest");
+
+        testProgram.Build();
+        testProgram.Run();
+
+        var endpoints = testProgram.ServiceABuilder.Resource.Annotations.OfType<EndpointAnnotation>();
+        Assert.True(endpoints.Single(e => e.Name == "https").IsExternal);
+        Assert.False(endpoints.Single(e => e.Name == "http").IsExternal);
+        Assert.False(endpoints.Single(e => e.Name == "admin").IsExternal);
+    }
+
+    [Fact]
+    public void MultipleCallsAccumulateEndpointNames()
+    {
+        var testProgram = CreateTestProgram(["--publisher", "manifest"]);
+        testProgram.ServiceABuilder.WithEndpoint(9999, scheme: "tcp", name: "admin");
+        testProgram.ServiceABuilder.WithExternalEndpoints("https");
+        testProgram.ServiceABuilder.WithExternalEndpoints("admin");
+
+        // Block DCP from actually starting anything up as we don't need it for this test.
+        testProgram.AppBuilder.Services.AddKeyedSingleton<IDistributedApplicationPublisher, NoopPublisher>("manifest");
+
+        testProgram.Build();
+        testProgram.Run();
+
+        var endpoints = testProgram.ServiceABuilder.Resource.Annotations.OfType<EndpointAnnotation>();
+        Assert.True(endpoints.Single(e => e.Name == "https").IsExternal);
+        Assert.True(endpoints.Single(e => e.Name == "admin").IsExternal);
+        Assert.False(endpoints.Single(e => e.Name == "http").IsExternal);
+    }
+
+    [Fact]
+    public async Task UnknownEndpointNameThrows()
+    {
+        var testProgram = CreateTestProgram(["--publisher", "manifest"]);
+        testProgram.ServiceABuilder.WithExternalEndpoints("doesnotexist");
+
+        // Block DCP from actually starting anything up as we don't need it for this test.
+        testProgram.AppBuilder.Services.AddKeyedSingleton<IDistributedApplicationPublisher, NoopPublisher>("manifest");
+
+        var ex = await Assert.ThrowsAsync<DistributedApplicationException>(async () =>
+        {
+            var cts = new CancellationTokenSource();
+            cts.CancelAfter(TimeSpan.FromMinutes(1));
+            await testProgram.RunAsync(cts.Token);
+        });
+
+        Assert.Equal("Resource 'servicea' does not have an endpoint named 'doesnotexist' to expose externally.", ex.Message);
+    }
+
+    [Fact]
+    public async Task EndpointMissingLocallyDoesNotThrowWhenRunningWithDcp()
+    {
+        var appBuilder = DistributedApplication.CreateBuilder();
+        var container = appBuilder.AddContainer("container", "image")
+                                  .WithEndpoint(containerPort: 80, scheme: "http", name: "http")
+                                  .WithExternalEndpoints("https");
+
+        // The hook only runs its validation for the manifest publisher, so a name that does not match
+        // any endpoint is ignored when running locally.
+        var hook = new ExternalEndpointsMutationHook(Options.Create(new PublishingOptions { Publisher = "dcp" }));
+        await hook.BeforeStartAsync(new DistributedApplicationModel(appBuilder.Resources));
+
+        var endpoint = Assert.Single(container.Resource.Annotations.OfType<EndpointAnnotation>());
+        Assert.False(endpoint.IsExternal);
+    }
+
+    [Fact]
+    public void ManifestMarksOnlyNamedBindingsExternal()
+    {
+        // The manifest is kept in memory, so the output path is never written to.
+        var manifestPath = Path.Combine(Path.GetTempPath(), $"{Guid.NewGuid():N}.json");
+        var testProgram = CreateTestProgram(["--publisher", "manifest", "--output-path", manifestPath]);
+        testProgram.ServiceABuilder.WithExternalEndpoints("https");
+        testProgram.AppBuilder.Services.AddKeyedSingleton<IDistributedApplicationPublisher, JsonDocumentManifestPublisher>("manifest");
+
+        // Build AppHost so that publisher can be resolved.
+        testProgram.Build();
+        var publisher = testProgram.GetManifestPublisher();
+
+        testProgram.Run();
+
+        var bindings = publisher.ManifestDocument.RootElement.GetProperty("resources").GetProperty("servicea").GetProperty("bindings");
+        Assert.True(bindings.GetProperty("https").GetProperty("external").GetBoolean());
+        Assert.False(bindings.GetProperty("http").TryGetProperty("external", out _));
+    }
+
+    private static TestProgram CreateTestProgram(string[] args) => TestProgram.Create<WithExternalEndpointsTests>(args);
+}